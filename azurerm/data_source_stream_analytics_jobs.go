@@ -0,0 +1,98 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/streamanalytics/mgmt/2016-03-01/streamanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmStreamAnalyticsJobs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmStreamAnalyticsJobsRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"jobs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"job_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmStreamAnalyticsJobsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsJobsClient
+	ctx := meta.(*ArmClient).StopContext
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	jobs := make([]streamanalytics.StreamingJob, 0)
+	results, err := client.ListByResourceGroupComplete(ctx, resourceGroup, "")
+	if err != nil {
+		return fmt.Errorf("Error listing Stream Analytics Jobs (Resource Group %q): %+v", resourceGroup, err)
+	}
+
+	for results.NotDone() {
+		jobs = append(jobs, results.Value())
+
+		if err := results.Next(); err != nil {
+			return fmt.Errorf("Error listing the next page of Stream Analytics Jobs (Resource Group %q): %+v", resourceGroup, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.StreamAnalytics/streamingjobs", subscriptionId, resourceGroup))
+	d.Set("resource_group_name", resourceGroup)
+
+	if err := d.Set("jobs", flattenStreamAnalyticsJobs(jobs)); err != nil {
+		return fmt.Errorf("Error setting `jobs`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenStreamAnalyticsJobs(input []streamanalytics.StreamingJob) []interface{} {
+	output := make([]interface{}, 0)
+
+	for _, job := range input {
+		result := make(map[string]interface{}, 0)
+
+		if job.Name != nil {
+			result["name"] = *job.Name
+		}
+
+		if job.ID != nil {
+			result["id"] = *job.ID
+		}
+
+		if props := job.StreamingJobProperties; props != nil {
+			if props.JobState != nil {
+				result["job_state"] = *props.JobState
+			}
+		}
+
+		output = append(output, result)
+	}
+
+	return output
+}
@@ -0,0 +1,42 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMStreamAnalyticsJobs_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_stream_analytics_jobs.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMStreamAnalyticsJobs_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "jobs.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "jobs.0.name", fmt.Sprintf("acctestjob-%d", ri)),
+					resource.TestCheckResourceAttr(dataSourceName, "jobs.0.job_state", "Created"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "jobs.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMStreamAnalyticsJobs_basic(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsJob_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_stream_analytics_jobs" "test" {
+  resource_group_name = "${azurerm_template_deployment.test.resource_group_name}"
+}
+`, template)
+}
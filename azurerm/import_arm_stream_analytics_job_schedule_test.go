@@ -0,0 +1,33 @@
+package azurerm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMStreamAnalyticsJobSchedule_importBasic(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_job_schedule.test"
+
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(5))
+	config := testAccAzureRMStreamAnalyticsJobSchedule_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsJobScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,35 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMStreamAnalyticsOutputCosmosDB_importBasic(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_cosmosdb.test"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMStreamAnalyticsOutputCosmosDB_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputCosmosDBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					// not returned from the API
+					"account_key",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,35 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMStreamAnalyticsOutputPowerBI_importBasic(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_powerbi.test"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMStreamAnalyticsOutputPowerBI_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputPowerBIDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					// not returned from the API
+					"refresh_token",
+				},
+			},
+		},
+	})
+}
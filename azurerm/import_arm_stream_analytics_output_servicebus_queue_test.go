@@ -0,0 +1,35 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMStreamAnalyticsOutputServiceBusQueue_importJson(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_servicebus_queue.test"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMStreamAnalyticsOutputServiceBusQueue_json(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputServiceBusQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					// not returned from the API
+					"shared_access_policy_key",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,35 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMStreamAnalyticsOutputServiceBusTopic_importJson(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_servicebus_topic.test"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMStreamAnalyticsOutputServiceBusTopic_json(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputServiceBusTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					// not returned from the API
+					"shared_access_policy_key",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,37 @@
+package azurerm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMStreamAnalyticsOutputTable_importBasic(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_table.test"
+
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(5))
+	config := testAccAzureRMStreamAnalyticsOutputTable_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					// not returned from the API
+					"storage_account_key",
+				},
+			},
+		},
+	})
+}
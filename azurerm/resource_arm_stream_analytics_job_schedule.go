@@ -0,0 +1,280 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/streamanalytics/mgmt/2016-03-01/streamanalytics"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const (
+	streamAnalyticsJobStateRunning = "Running"
+	streamAnalyticsJobStateStopped = "Stopped"
+)
+
+func resourceArmStreamAnalyticsJobSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStreamAnalyticsJobScheduleCreateUpdate,
+		Read:   resourceArmStreamAnalyticsJobScheduleRead,
+		Update: resourceArmStreamAnalyticsJobScheduleCreateUpdate,
+		Delete: resourceArmStreamAnalyticsJobScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"stream_analytics_job_name": streamAnalyticsJobNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"job_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  streamAnalyticsJobStateRunning,
+				ValidateFunc: validation.StringInSlice([]string{
+					streamAnalyticsJobStateRunning,
+					streamAnalyticsJobStateStopped,
+				}, false),
+			},
+
+			"output_start_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(streamanalytics.JobStartTime),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(streamanalytics.CustomTime),
+					string(streamanalytics.JobStartTime),
+					string(streamanalytics.LastOutputEventTime),
+				}, false),
+			},
+
+			"output_start_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateRFC3339Date,
+				DiffSuppressFunc: suppressStreamAnalyticsOutputStartTimeDiff,
+			},
+		},
+	}
+}
+
+func resourceArmStreamAnalyticsJobScheduleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsJobsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Azure Stream Analytics Job Schedule creation/update.")
+
+	jobName := d.Get("stream_analytics_job_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	jobState := d.Get("job_state").(string)
+	outputStartMode := d.Get("output_start_mode").(string)
+	outputStartTime := d.Get("output_start_time").(string)
+
+	parameters := streamanalytics.StartStreamingJobParameters{
+		OutputStartMode: streamanalytics.OutputStartMode(outputStartMode),
+	}
+	if outputStartMode == string(streamanalytics.CustomTime) {
+		if outputStartTime == "" {
+			return fmt.Errorf("`output_start_time` must be specified when `output_start_mode` is set to `CustomTime`")
+		}
+
+		startTime, err := date.ParseTime(time.RFC3339, outputStartTime)
+		if err != nil {
+			return fmt.Errorf("Error parsing `output_start_time` %q: %+v", outputStartTime, err)
+		}
+
+		parameters.OutputStartTime = &date.Time{Time: startTime}
+	}
+
+	job, err := client.Get(ctx, resourceGroup, jobName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Stream Analytics Job %q (Resource Group %q): %+v", jobName, resourceGroup, err)
+	}
+	if job.ID == nil {
+		return fmt.Errorf("Cannot read ID of Stream Analytics Job %q (Resource Group %q)", jobName, resourceGroup)
+	}
+
+	running := false
+	if props := job.StreamingJobProperties; props != nil && props.JobState != nil {
+		running = isStreamAnalyticsJobRunning(*props.JobState)
+	}
+
+	// the Output Start Mode/Time are only used when the Job is started, so restart a running Job to apply them
+	// (the Output Start Time is ignored unless the Output Start Mode is `CustomTime`)
+	restart := false
+	if running {
+		if d.IsNewResource() {
+			// the Job may have been started outside of Terraform, so compare against the settings it was started with
+			restart = !streamAnalyticsJobStartedWith(*job.StreamingJobProperties, parameters)
+		} else {
+			startTimeChanged := outputStartMode == string(streamanalytics.CustomTime) && d.HasChange("output_start_time")
+			restart = d.HasChange("output_start_mode") || startTimeChanged
+		}
+	}
+
+	if running && (jobState == streamAnalyticsJobStateStopped || restart) {
+		if err := stopStreamAnalyticsJob(client, resourceGroup, jobName, meta); err != nil {
+			return err
+		}
+		running = false
+	}
+
+	if !running && jobState == streamAnalyticsJobStateRunning {
+		log.Printf("[DEBUG] Starting Stream Analytics Job %q (Resource Group %q)..", jobName, resourceGroup)
+		future, err := client.Start(ctx, resourceGroup, jobName, &parameters)
+		if err != nil {
+			return fmt.Errorf("Error starting Stream Analytics Job %q (Resource Group %q): %+v", jobName, resourceGroup, err)
+		}
+
+		if err := future.WaitForCompletion(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Stream Analytics Job %q (Resource Group %q) to start: %+v", jobName, resourceGroup, err)
+		}
+	}
+
+	d.SetId(*job.ID)
+
+	return resourceArmStreamAnalyticsJobScheduleRead(d, meta)
+}
+
+func resourceArmStreamAnalyticsJobScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsJobsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+
+	resp, err := client.Get(ctx, resourceGroup, jobName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Stream Analytics Job %q was not found in Resource Group %q - removing Schedule from state!", jobName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Stream Analytics Job %q (Resource Group %q): %+v", jobName, resourceGroup, err)
+	}
+
+	d.Set("stream_analytics_job_name", jobName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.StreamingJobProperties; props != nil {
+		running := props.JobState != nil && isStreamAnalyticsJobRunning(*props.JobState)
+		if running {
+			d.Set("job_state", streamAnalyticsJobStateRunning)
+		} else {
+			d.Set("job_state", streamAnalyticsJobStateStopped)
+		}
+
+		// the Output Start Mode/Time returned from the API are those from when the Job was last started,
+		// so these are only representative of the configuration whilst the Job is running
+		if running {
+			if props.OutputStartMode != "" {
+				d.Set("output_start_mode", string(props.OutputStartMode))
+			}
+
+			// the API also returns the time the Job started from when using other modes, which isn't configurable
+			if props.OutputStartMode == streamanalytics.CustomTime {
+				if v := props.OutputStartTime; v != nil {
+					d.Set("output_start_time", v.Format(time.RFC3339))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStreamAnalyticsJobScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsJobsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+
+	resp, err := client.Get(ctx, resourceGroup, jobName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Stream Analytics Job %q (Resource Group %q): %+v", jobName, resourceGroup, err)
+	}
+
+	if props := resp.StreamingJobProperties; props != nil && props.JobState != nil {
+		if isStreamAnalyticsJobRunning(*props.JobState) {
+			return stopStreamAnalyticsJob(client, resourceGroup, jobName, meta)
+		}
+	}
+
+	return nil
+}
+
+func stopStreamAnalyticsJob(client streamanalytics.StreamingJobsClient, resourceGroup string, jobName string, meta interface{}) error {
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[DEBUG] Stopping Stream Analytics Job %q (Resource Group %q)..", jobName, resourceGroup)
+	future, err := client.Stop(ctx, resourceGroup, jobName)
+	if err != nil {
+		return fmt.Errorf("Error stopping Stream Analytics Job %q (Resource Group %q): %+v", jobName, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletion(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Stream Analytics Job %q (Resource Group %q) to stop: %+v", jobName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// streamAnalyticsJobStartedWith returns whether the Job was last started using the specified Output Start Mode/Time
+func streamAnalyticsJobStartedWith(props streamanalytics.StreamingJobProperties, parameters streamanalytics.StartStreamingJobParameters) bool {
+	if props.OutputStartMode != parameters.OutputStartMode {
+		return false
+	}
+
+	if parameters.OutputStartMode == streamanalytics.CustomTime {
+		return props.OutputStartTime != nil && parameters.OutputStartTime != nil && props.OutputStartTime.Equal(parameters.OutputStartTime.Time)
+	}
+
+	return true
+}
+
+// suppressStreamAnalyticsOutputStartTimeDiff ignores differences in the formatting of the timestamp
+// (e.g. the offset used) since the API returns it in UTC
+func suppressStreamAnalyticsOutputStartTimeDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := date.ParseTime(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+
+	newTime, err := date.ParseTime(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
+
+// isStreamAnalyticsJobRunning returns whether the Job is started, which includes the
+// transitional/degraded states Azure reports for a Job which hasn't been stopped
+func isStreamAnalyticsJobRunning(jobState string) bool {
+	switch strings.ToLower(jobState) {
+	case "running", "starting", "idle", "degraded", "scaling":
+		return true
+	}
+
+	return false
+}
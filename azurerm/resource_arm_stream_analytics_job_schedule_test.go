@@ -0,0 +1,572 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/streamanalytics/mgmt/2016-03-01/streamanalytics"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestStreamAnalyticsJobRunningStates(t *testing.T) {
+	cases := []struct {
+		JobState string
+		Running  bool
+	}{
+		{
+			JobState: "Created",
+			Running:  false,
+		},
+		{
+			JobState: "Starting",
+			Running:  true,
+		},
+		{
+			JobState: "Running",
+			Running:  true,
+		},
+		{
+			JobState: "Idle",
+			Running:  true,
+		},
+		{
+			JobState: "Degraded",
+			Running:  true,
+		},
+		{
+			JobState: "Stopping",
+			Running:  false,
+		},
+		{
+			JobState: "Stopped",
+			Running:  false,
+		},
+		{
+			JobState: "Failed",
+			Running:  false,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := isStreamAnalyticsJobRunning(tc.JobState); actual != tc.Running {
+			t.Fatalf("Expected Job State %q to be running %t but got %t", tc.JobState, tc.Running, actual)
+		}
+	}
+}
+
+func TestSuppressStreamAnalyticsOutputStartTimeDiff(t *testing.T) {
+	cases := []struct {
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			Old:      "2018-07-01T00:00:00Z",
+			New:      "2018-07-01T00:00:00Z",
+			Suppress: true,
+		},
+		{
+			Old:      "2018-06-30T23:00:00Z",
+			New:      "2018-07-01T00:00:00+01:00",
+			Suppress: true,
+		},
+		{
+			Old:      "2018-07-01T00:00:00Z",
+			New:      "2018-07-01T00:00:00.000Z",
+			Suppress: true,
+		},
+		{
+			Old:      "2018-07-01T00:00:00Z",
+			New:      "2018-07-01T00:00:00+01:00",
+			Suppress: false,
+		},
+		{
+			Old:      "",
+			New:      "2018-07-01T00:00:00Z",
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := suppressStreamAnalyticsOutputStartTimeDiff("output_start_time", tc.Old, tc.New, nil); actual != tc.Suppress {
+			t.Fatalf("Expected the diff between %q and %q to be suppressed %t but got %t", tc.Old, tc.New, tc.Suppress, actual)
+		}
+	}
+}
+
+func TestStreamAnalyticsJobStartedWith(t *testing.T) {
+	customTime := date.Time{Time: time.Date(2018, 7, 1, 0, 0, 0, 0, time.UTC)}
+	otherTime := date.Time{Time: time.Date(2018, 7, 2, 0, 0, 0, 0, time.UTC)}
+
+	cases := []struct {
+		Properties  streamanalytics.StreamingJobProperties
+		Parameters  streamanalytics.StartStreamingJobParameters
+		StartedWith bool
+	}{
+		{
+			Properties: streamanalytics.StreamingJobProperties{
+				OutputStartMode: streamanalytics.JobStartTime,
+				OutputStartTime: &otherTime,
+			},
+			Parameters: streamanalytics.StartStreamingJobParameters{
+				OutputStartMode: streamanalytics.JobStartTime,
+			},
+			StartedWith: true,
+		},
+		{
+			Properties: streamanalytics.StreamingJobProperties{
+				OutputStartMode: streamanalytics.JobStartTime,
+				OutputStartTime: &otherTime,
+			},
+			Parameters: streamanalytics.StartStreamingJobParameters{
+				OutputStartMode: streamanalytics.CustomTime,
+				OutputStartTime: &customTime,
+			},
+			StartedWith: false,
+		},
+		{
+			Properties: streamanalytics.StreamingJobProperties{
+				OutputStartMode: streamanalytics.CustomTime,
+				OutputStartTime: &date.Time{Time: customTime.In(time.FixedZone("BST", 3600))},
+			},
+			Parameters: streamanalytics.StartStreamingJobParameters{
+				OutputStartMode: streamanalytics.CustomTime,
+				OutputStartTime: &customTime,
+			},
+			StartedWith: true,
+		},
+		{
+			Properties: streamanalytics.StreamingJobProperties{
+				OutputStartMode: streamanalytics.CustomTime,
+				OutputStartTime: &otherTime,
+			},
+			Parameters: streamanalytics.StartStreamingJobParameters{
+				OutputStartMode: streamanalytics.CustomTime,
+				OutputStartTime: &customTime,
+			},
+			StartedWith: false,
+		},
+	}
+
+	for i, tc := range cases {
+		if actual := streamAnalyticsJobStartedWith(tc.Properties, tc.Parameters); actual != tc.StartedWith {
+			t.Fatalf("Expected case %d to be started with the parameters %t but got %t", i, tc.StartedWith, actual)
+		}
+	}
+}
+
+func TestAccAzureRMStreamAnalyticsJobSchedule_basic(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_job_schedule.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(5))
+	config := testAccAzureRMStreamAnalyticsJobSchedule_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsJobScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsJobScheduleState(resourceName, "Running"),
+					resource.TestCheckResourceAttr(resourceName, "job_state", "Running"),
+					resource.TestCheckResourceAttr(resourceName, "output_start_mode", "JobStartTime"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStreamAnalyticsJobSchedule_stopped(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_job_schedule.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(5))
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsJobScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStreamAnalyticsJobSchedule_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsJobScheduleState(resourceName, "Running"),
+				),
+			},
+			{
+				// re-applying `Running` to a Job which is already running shouldn't error
+				Config: testAccAzureRMStreamAnalyticsJobSchedule_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsJobScheduleState(resourceName, "Running"),
+					resource.TestCheckResourceAttr(resourceName, "job_state", "Running"),
+				),
+			},
+			{
+				// updating the resource whilst the Job's running (without needing a restart) shouldn't try to start it again
+				Config: testAccAzureRMStreamAnalyticsJobSchedule_ignoredStartTime(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsJobScheduleState(resourceName, "Running"),
+					resource.TestCheckResourceAttr(resourceName, "job_state", "Running"),
+					resource.TestCheckResourceAttr(resourceName, "output_start_time", "2018-07-01T00:00:00Z"),
+				),
+			},
+			{
+				Config: testAccAzureRMStreamAnalyticsJobSchedule_stopped(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsJobScheduleState(resourceName, "Stopped"),
+					resource.TestCheckResourceAttr(resourceName, "job_state", "Stopped"),
+				),
+			},
+			{
+				Config: testAccAzureRMStreamAnalyticsJobSchedule_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsJobScheduleState(resourceName, "Running"),
+					resource.TestCheckResourceAttr(resourceName, "job_state", "Running"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStreamAnalyticsJobSchedule_customTime(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_job_schedule.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(5))
+	config := testAccAzureRMStreamAnalyticsJobSchedule_customTime(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsJobScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsJobScheduleState(resourceName, "Running"),
+					resource.TestCheckResourceAttr(resourceName, "output_start_mode", "CustomTime"),
+				),
+			},
+			{
+				// the API returns the timestamp in UTC, which shouldn't cause a diff
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStreamAnalyticsJobSchedule_alreadyRunning(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_job_schedule.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(5))
+	location := testLocation()
+	config := testAccAzureRMStreamAnalyticsJobSchedule_customTime(ri, rs, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsJobScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStreamAnalyticsJobSchedule_template(ri, rs, location),
+			},
+			{
+				// the Job's started outside of Terraform with a different Output Start Mode, so should be restarted
+				PreConfig: testStartAzureRMStreamAnalyticsJob(t, fmt.Sprintf("acctestRG-%d", ri), fmt.Sprintf("acctestjob-%d", ri)),
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsJobScheduleState(resourceName, "Running"),
+					resource.TestCheckResourceAttr(resourceName, "output_start_mode", "CustomTime"),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// testStartAzureRMStreamAnalyticsJob starts the Job using the `JobStartTime` Output Start Mode
+func testStartAzureRMStreamAnalyticsJob(t *testing.T, resourceGroup string, jobName string) func() {
+	return func() {
+		conn := testAccProvider.Meta().(*ArmClient).streamAnalyticsJobsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		parameters := streamanalytics.StartStreamingJobParameters{
+			OutputStartMode: streamanalytics.JobStartTime,
+		}
+		future, err := conn.Start(ctx, resourceGroup, jobName, &parameters)
+		if err != nil {
+			t.Fatalf("Bad: Start on streamAnalyticsJobsClient: %+v", err)
+		}
+
+		if err := future.WaitForCompletion(ctx, conn.Client); err != nil {
+			t.Fatalf("Bad: waiting for Stream Analytics Job %q (Resource Group %q) to start: %+v", jobName, resourceGroup, err)
+		}
+	}
+}
+
+func testCheckAzureRMStreamAnalyticsJobScheduleState(name string, expectedState string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		conn := testAccProvider.Meta().(*ArmClient).streamAnalyticsJobsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := conn.Get(ctx, resourceGroup, jobName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Stream Analytics Job %q (Resource Group %q) does not exist", jobName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on streamAnalyticsJobsClient: %+v", err)
+		}
+
+		running := false
+		if props := resp.StreamingJobProperties; props != nil && props.JobState != nil {
+			running = isStreamAnalyticsJobRunning(*props.JobState)
+		}
+
+		if running != (expectedState == "Running") {
+			return fmt.Errorf("Bad: expected Stream Analytics Job %q (Resource Group %q) to be %q", jobName, resourceGroup, expectedState)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStreamAnalyticsJobScheduleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).streamAnalyticsJobsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_stream_analytics_job_schedule" {
+			continue
+		}
+
+		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		resp, err := conn.Get(ctx, resourceGroup, jobName, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+
+			continue
+		}
+
+		if props := resp.StreamingJobProperties; props != nil && props.JobState != nil {
+			if isStreamAnalyticsJobRunning(*props.JobState) {
+				return fmt.Errorf("Stream Analytics Job %q (Resource Group %q) is still running", jobName, resourceGroup)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMStreamAnalyticsJobSchedule_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMStreamAnalyticsJobSchedule_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_job_schedule" "test" {
+  stream_analytics_job_name = "${lookup(azurerm_template_deployment.test.outputs, "jobName")}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+}
+`, template)
+}
+
+func testAccAzureRMStreamAnalyticsJobSchedule_stopped(rInt int, rString string, location string) string {
+	template := testAccAzureRMStreamAnalyticsJobSchedule_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_job_schedule" "test" {
+  stream_analytics_job_name = "${lookup(azurerm_template_deployment.test.outputs, "jobName")}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  job_state                 = "Stopped"
+}
+`, template)
+}
+
+func testAccAzureRMStreamAnalyticsJobSchedule_ignoredStartTime(rInt int, rString string, location string) string {
+	template := testAccAzureRMStreamAnalyticsJobSchedule_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_job_schedule" "test" {
+  stream_analytics_job_name = "${lookup(azurerm_template_deployment.test.outputs, "jobName")}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  output_start_mode         = "JobStartTime"
+  output_start_time         = "2018-07-01T00:00:00Z"
+}
+`, template)
+}
+
+func testAccAzureRMStreamAnalyticsJobSchedule_customTime(rInt int, rString string, location string) string {
+	template := testAccAzureRMStreamAnalyticsJobSchedule_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_job_schedule" "test" {
+  stream_analytics_job_name = "${lookup(azurerm_template_deployment.test.outputs, "jobName")}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  output_start_mode         = "CustomTime"
+  output_start_time         = "2018-07-01T00:00:00+01:00"
+}
+`, template)
+}
+
+// testAccAzureRMStreamAnalyticsJobSchedule_template provisions a Stream Analytics Job with an Input, Output and
+// Transformation via a Template Deployment, since a Job can only be started once these are present.
+func testAccAzureRMStreamAnalyticsJobSchedule_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "acctestcontainer"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "Incremental"
+
+  parameters {
+    storageAccountName = "${azurerm_storage_account.test.name}"
+    storageAccountKey  = "${azurerm_storage_account.test.primary_access_key}"
+    containerName      = "${azurerm_storage_container.test.name}"
+  }
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "storageAccountName": {
+      "type": "string"
+    },
+    "storageAccountKey": {
+      "type": "securestring"
+    },
+    "containerName": {
+      "type": "string"
+    }
+  },
+  "variables": {
+    "storageAccounts": [
+      {
+        "accountName": "[parameters('storageAccountName')]",
+        "accountKey": "[parameters('storageAccountKey')]"
+      }
+    ],
+    "serialization": {
+      "type": "Json",
+      "properties": {
+        "encoding": "UTF8",
+        "format": "LineSeparated"
+      }
+    }
+  },
+  "resources": [
+    {
+      "type": "Microsoft.StreamAnalytics/streamingjobs",
+      "apiVersion": "2016-03-01",
+      "name": "acctestjob-%d",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "sku": {
+          "name": "Standard"
+        },
+        "eventsOutOfOrderPolicy": "Adjust",
+        "outputErrorPolicy": "Drop",
+        "eventsOutOfOrderMaxDelayInSeconds": 0,
+        "eventsLateArrivalMaxDelayInSeconds": 5,
+        "dataLocale": "en-GB",
+        "compatibilityLevel": "1.0",
+        "inputs": [
+          {
+            "name": "acctestinput",
+            "properties": {
+              "type": "Stream",
+              "datasource": {
+                "type": "Microsoft.Storage/Blob",
+                "properties": {
+                  "storageAccounts": "[variables('storageAccounts')]",
+                  "container": "[parameters('containerName')]",
+                  "pathPattern": "input/{date}",
+                  "dateFormat": "yyyy/MM/dd"
+                }
+              },
+              "serialization": "[variables('serialization')]"
+            }
+          }
+        ],
+        "outputs": [
+          {
+            "name": "acctestoutput",
+            "properties": {
+              "datasource": {
+                "type": "Microsoft.Storage/Blob",
+                "properties": {
+                  "storageAccounts": "[variables('storageAccounts')]",
+                  "container": "[parameters('containerName')]",
+                  "pathPattern": "output/{date}",
+                  "dateFormat": "yyyy/MM/dd"
+                }
+              },
+              "serialization": "[variables('serialization')]"
+            }
+          }
+        ],
+        "transformation": {
+          "name": "acctesttransformation",
+          "properties": {
+            "streamingUnits": 1,
+            "query": "SELECT * INTO [acctestoutput] FROM [acctestinput]"
+          }
+        }
+      }
+    }
+  ],
+  "outputs": {
+    "jobName": {
+      "type": "string",
+      "value": "acctestjob-%d"
+    }
+  }
+}
+DEPLOY
+}
+`, rInt, location, rString, rInt, rInt, rInt)
+}
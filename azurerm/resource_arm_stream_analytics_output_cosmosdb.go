@@ -0,0 +1,183 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/streamanalytics/mgmt/2016-03-01/streamanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStreamAnalyticsOutputCosmosDB() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStreamAnalyticsOutputCosmosDBCreateUpdate,
+		Read:   resourceArmStreamAnalyticsOutputCosmosDBRead,
+		Update: resourceArmStreamAnalyticsOutputCosmosDBCreateUpdate,
+		Delete: resourceArmStreamAnalyticsOutputCosmosDBDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"stream_analytics_job_name": streamAnalyticsJobNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"account_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"account_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+
+			"database": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"collection_name_pattern": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"partition_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"document_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmStreamAnalyticsOutputCosmosDBCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsOutputsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Azure Stream Analytics Output CosmosDB creation/update.")
+
+	name := d.Get("name").(string)
+	jobName := d.Get("stream_analytics_job_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	accountId := d.Get("account_id").(string)
+	accountKey := d.Get("account_key").(string)
+	database := d.Get("database").(string)
+	collectionNamePattern := d.Get("collection_name_pattern").(string)
+	partitionKey := d.Get("partition_key").(string)
+	documentId := d.Get("document_id").(string)
+
+	props := streamanalytics.Output{
+		Name: utils.String(name),
+		OutputProperties: &streamanalytics.OutputProperties{
+			Datasource: &streamanalytics.DocumentDbOutputDataSource{
+				Type: streamanalytics.TypeMicrosoftStorageDocumentDB,
+				DocumentDbOutputDataSourceProperties: &streamanalytics.DocumentDbOutputDataSourceProperties{
+					AccountID:             utils.String(accountId),
+					AccountKey:            utils.String(accountKey),
+					Database:              utils.String(database),
+					CollectionNamePattern: utils.String(collectionNamePattern),
+					PartitionKey:          utils.String(partitionKey),
+					DocumentID:            utils.String(documentId),
+				},
+			},
+		},
+	}
+
+	if _, err := client.CreateOrReplace(ctx, props, resourceGroup, jobName, name, "", ""); err != nil {
+		return fmt.Errorf("Error Creating/Updating Stream Analytics Output CosmosDB %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, jobName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Stream Analytics Output CosmosDB %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Stream Analytics Output CosmosDB %q (Job %q / Resource Group %q)", name, jobName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmStreamAnalyticsOutputCosmosDBRead(d, meta)
+}
+
+func resourceArmStreamAnalyticsOutputCosmosDBRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsOutputsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["outputs"]
+
+	resp, err := client.Get(ctx, resourceGroup, jobName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Stream Analytics Output CosmosDB %q was not found in Job %q / Resource Group %q - removing from state!", name, jobName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Stream Analytics Output CosmosDB %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("stream_analytics_job_name", jobName)
+
+	if props := resp.OutputProperties; props != nil {
+		v, ok := props.Datasource.AsDocumentDbOutputDataSource()
+		if !ok {
+			return fmt.Errorf("Error converting Output Data Source to a CosmosDB Output")
+		}
+
+		if cosmosDBProps := v.DocumentDbOutputDataSourceProperties; cosmosDBProps != nil {
+			d.Set("account_id", cosmosDBProps.AccountID)
+			d.Set("database", cosmosDBProps.Database)
+			d.Set("collection_name_pattern", cosmosDBProps.CollectionNamePattern)
+			d.Set("partition_key", cosmosDBProps.PartitionKey)
+			d.Set("document_id", cosmosDBProps.DocumentID)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStreamAnalyticsOutputCosmosDBDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsOutputsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["outputs"]
+
+	resp, err := client.Delete(ctx, resourceGroup, jobName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Stream Analytics Output CosmosDB %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMStreamAnalyticsOutputCosmosDB_basic(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_cosmosdb.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMStreamAnalyticsOutputCosmosDB_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputCosmosDBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputCosmosDBExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "database", "acctestdb"),
+					resource.TestCheckResourceAttr(resourceName, "collection_name_pattern", "collection{partition}"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStreamAnalyticsOutputCosmosDB_update(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_cosmosdb.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputCosmosDBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStreamAnalyticsOutputCosmosDB_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputCosmosDBExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMStreamAnalyticsOutputCosmosDB_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputCosmosDBExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "collection_name_pattern", "updated{partition}"),
+					resource.TestCheckResourceAttr(resourceName, "document_id", "documentId"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStreamAnalyticsOutputCosmosDBExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		conn := testAccProvider.Meta().(*ArmClient).streamAnalyticsOutputsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := conn.Get(ctx, resourceGroup, jobName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Stream Analytics Output CosmosDB %q (Job %q / Resource Group %q) does not exist", name, jobName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on streamAnalyticsOutputsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStreamAnalyticsOutputCosmosDBDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).streamAnalyticsOutputsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_stream_analytics_output_cosmosdb" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		resp, err := conn.Get(ctx, resourceGroup, jobName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+
+			continue
+		}
+
+		return fmt.Errorf("Stream Analytics Output CosmosDB %q (Job %q / Resource Group %q) still exists", name, jobName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMStreamAnalyticsOutputCosmosDB_basic(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsOutputCosmosDB_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_output_cosmosdb" "test" {
+  name                      = "acctestoutput-%d"
+  stream_analytics_job_name = "${lookup(azurerm_template_deployment.test.outputs, "jobName")}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  account_id                = "${azurerm_cosmosdb_account.test.name}"
+  account_key               = "${azurerm_cosmosdb_account.test.primary_master_key}"
+  database                  = "acctestdb"
+  collection_name_pattern   = "collection{partition}"
+  partition_key             = "partitionId"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMStreamAnalyticsOutputCosmosDB_updated(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsOutputCosmosDB_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_output_cosmosdb" "test" {
+  name                      = "acctestoutput-%d"
+  stream_analytics_job_name = "${lookup(azurerm_template_deployment.test.outputs, "jobName")}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  account_id                = "${azurerm_cosmosdb_account.test.name}"
+  account_key               = "${azurerm_cosmosdb_account.test.primary_master_key}"
+  database                  = "acctestdb"
+  collection_name_pattern   = "updated{partition}"
+  partition_key             = "partitionId"
+  document_id               = "documentId"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMStreamAnalyticsOutputCosmosDB_template(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsJob_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_account" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  offer_type          = "Standard"
+
+  consistency_policy {
+    consistency_level = "Session"
+  }
+
+  failover_policy {
+    location = "${azurerm_resource_group.test.location}"
+    priority = 0
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,188 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/streamanalytics/mgmt/2016-03-01/streamanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStreamAnalyticsOutputEventHub() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStreamAnalyticsOutputEventHubCreateUpdate,
+		Read:   resourceArmStreamAnalyticsOutputEventHubRead,
+		Update: resourceArmStreamAnalyticsOutputEventHubCreateUpdate,
+		Delete: resourceArmStreamAnalyticsOutputEventHubDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"stream_analytics_job_name": streamAnalyticsJobNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"eventhub_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"servicebus_namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"shared_access_policy_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+
+			"shared_access_policy_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"partition_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"serialization": streamAnalyticsOutputSerializationSchema(),
+		},
+	}
+}
+
+func resourceArmStreamAnalyticsOutputEventHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsOutputsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Azure Stream Analytics Output EventHub creation/update.")
+
+	name := d.Get("name").(string)
+	jobName := d.Get("stream_analytics_job_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	eventHubName := d.Get("eventhub_name").(string)
+	serviceBusNamespace := d.Get("servicebus_namespace").(string)
+	sharedAccessPolicyKey := d.Get("shared_access_policy_key").(string)
+	sharedAccessPolicyName := d.Get("shared_access_policy_name").(string)
+	partitionKey := d.Get("partition_key").(string)
+
+	serializationRaw := d.Get("serialization").([]interface{})
+	serialization, err := expandStreamAnalyticsOutputSerialization(serializationRaw)
+	if err != nil {
+		return fmt.Errorf("Error expanding `serialization`: %+v", err)
+	}
+
+	props := streamanalytics.Output{
+		Name: utils.String(name),
+		OutputProperties: &streamanalytics.OutputProperties{
+			Datasource: &streamanalytics.EventHubOutputDataSource{
+				Type: streamanalytics.TypeMicrosoftServiceBusEventHub,
+				EventHubOutputDataSourceProperties: &streamanalytics.EventHubOutputDataSourceProperties{
+					EventHubName:           utils.String(eventHubName),
+					ServiceBusNamespace:    utils.String(serviceBusNamespace),
+					SharedAccessPolicyKey:  utils.String(sharedAccessPolicyKey),
+					SharedAccessPolicyName: utils.String(sharedAccessPolicyName),
+					PartitionKey:           utils.String(partitionKey),
+				},
+			},
+			Serialization: serialization,
+		},
+	}
+
+	if _, err := client.CreateOrReplace(ctx, props, resourceGroup, jobName, name, "", ""); err != nil {
+		return fmt.Errorf("Error Creating/Updating Stream Analytics Output EventHub %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, jobName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Stream Analytics Output EventHub %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Stream Analytics Output EventHub %q (Job %q / Resource Group %q)", name, jobName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmStreamAnalyticsOutputEventHubRead(d, meta)
+}
+
+func resourceArmStreamAnalyticsOutputEventHubRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsOutputsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["outputs"]
+
+	resp, err := client.Get(ctx, resourceGroup, jobName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Stream Analytics Output EventHub %q was not found in Job %q / Resource Group %q - removing from state!", name, jobName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Stream Analytics Output EventHub %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("stream_analytics_job_name", jobName)
+
+	if props := resp.OutputProperties; props != nil {
+		v, ok := props.Datasource.AsEventHubOutputDataSource()
+		if !ok {
+			return fmt.Errorf("Error converting Output Data Source to an EventHub Output")
+		}
+
+		if eventHubProps := v.EventHubOutputDataSourceProperties; eventHubProps != nil {
+			d.Set("eventhub_name", eventHubProps.EventHubName)
+			d.Set("servicebus_namespace", eventHubProps.ServiceBusNamespace)
+			d.Set("shared_access_policy_name", eventHubProps.SharedAccessPolicyName)
+			d.Set("partition_key", eventHubProps.PartitionKey)
+		}
+
+		if err := d.Set("serialization", flattenStreamAnalyticsOutputSerialization(props.Serialization)); err != nil {
+			return fmt.Errorf("Error setting `serialization`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStreamAnalyticsOutputEventHubDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsOutputsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["outputs"]
+
+	resp, err := client.Delete(ctx, resourceGroup, jobName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Stream Analytics Output EventHub %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
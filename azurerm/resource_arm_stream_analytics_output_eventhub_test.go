@@ -0,0 +1,272 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMStreamAnalyticsOutputEventHub_avro(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_eventhub.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMStreamAnalyticsOutputEventHub_avro(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputEventHubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputEventHubExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "serialization.0.type", "Avro"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStreamAnalyticsOutputEventHub_csv(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_eventhub.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMStreamAnalyticsOutputEventHub_csv(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputEventHubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputEventHubExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "serialization.0.type", "Csv"),
+					resource.TestCheckResourceAttr(resourceName, "serialization.0.field_delimiter", ","),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStreamAnalyticsOutputEventHub_json(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_eventhub.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMStreamAnalyticsOutputEventHub_json(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputEventHubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputEventHubExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "serialization.0.type", "Json"),
+					resource.TestCheckResourceAttr(resourceName, "serialization.0.format", "LineSeparated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStreamAnalyticsOutputEventHub_update(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_eventhub.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputEventHubDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStreamAnalyticsOutputEventHub_json(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputEventHubExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMStreamAnalyticsOutputEventHub_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputEventHubExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "partition_key", "partitionId"),
+					resource.TestCheckResourceAttr(resourceName, "serialization.0.type", "Json"),
+					resource.TestCheckResourceAttr(resourceName, "serialization.0.format", "Array"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStreamAnalyticsOutputEventHubExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		conn := testAccProvider.Meta().(*ArmClient).streamAnalyticsOutputsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := conn.Get(ctx, resourceGroup, jobName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Stream Analytics Output EventHub %q (Job %q / Resource Group %q) does not exist", name, jobName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on streamAnalyticsOutputsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStreamAnalyticsOutputEventHubDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).streamAnalyticsOutputsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_stream_analytics_output_eventhub" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		resp, err := conn.Get(ctx, resourceGroup, jobName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+
+			continue
+		}
+
+		return fmt.Errorf("Stream Analytics Output EventHub %q (Job %q / Resource Group %q) still exists", name, jobName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMStreamAnalyticsOutputEventHub_avro(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsOutputEventHub_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_output_eventhub" "test" {
+  name                      = "acctestoutput-%d"
+  stream_analytics_job_name = "${lookup(azurerm_template_deployment.test.outputs, "jobName")}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  eventhub_name             = "${azurerm_eventhub.test.name}"
+  servicebus_namespace      = "${azurerm_eventhub_namespace.test.name}"
+  shared_access_policy_key  = "${azurerm_eventhub_namespace.test.default_primary_key}"
+  shared_access_policy_name = "RootManageSharedAccessKey"
+
+  serialization {
+    type = "Avro"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMStreamAnalyticsOutputEventHub_csv(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsOutputEventHub_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_output_eventhub" "test" {
+  name                      = "acctestoutput-%d"
+  stream_analytics_job_name = "${lookup(azurerm_template_deployment.test.outputs, "jobName")}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  eventhub_name             = "${azurerm_eventhub.test.name}"
+  servicebus_namespace      = "${azurerm_eventhub_namespace.test.name}"
+  shared_access_policy_key  = "${azurerm_eventhub_namespace.test.default_primary_key}"
+  shared_access_policy_name = "RootManageSharedAccessKey"
+
+  serialization {
+    type            = "Csv"
+    encoding        = "UTF8"
+    field_delimiter = ","
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMStreamAnalyticsOutputEventHub_json(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsOutputEventHub_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_output_eventhub" "test" {
+  name                      = "acctestoutput-%d"
+  stream_analytics_job_name = "${lookup(azurerm_template_deployment.test.outputs, "jobName")}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  eventhub_name             = "${azurerm_eventhub.test.name}"
+  servicebus_namespace      = "${azurerm_eventhub_namespace.test.name}"
+  shared_access_policy_key  = "${azurerm_eventhub_namespace.test.default_primary_key}"
+  shared_access_policy_name = "RootManageSharedAccessKey"
+
+  serialization {
+    type     = "Json"
+    encoding = "UTF8"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMStreamAnalyticsOutputEventHub_updated(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsOutputEventHub_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_output_eventhub" "test" {
+  name                      = "acctestoutput-%d"
+  stream_analytics_job_name = "${lookup(azurerm_template_deployment.test.outputs, "jobName")}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  eventhub_name             = "${azurerm_eventhub.test.name}"
+  servicebus_namespace      = "${azurerm_eventhub_namespace.test.name}"
+  shared_access_policy_key  = "${azurerm_eventhub_namespace.test.default_primary_key}"
+  shared_access_policy_name = "RootManageSharedAccessKey"
+  partition_key             = "partitionId"
+
+  serialization {
+    type     = "Json"
+    encoding = "UTF8"
+    format   = "Array"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMStreamAnalyticsOutputEventHub_template(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsJob_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+  capacity            = 1
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctesteventhub-%d"
+  namespace_name      = "${azurerm_eventhub_namespace.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  partition_count     = 2
+  message_retention   = 1
+}
+`, template, rInt, rInt)
+}
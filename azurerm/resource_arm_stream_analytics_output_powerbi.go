@@ -0,0 +1,211 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/streamanalytics/mgmt/2016-03-01/streamanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStreamAnalyticsOutputPowerBI() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStreamAnalyticsOutputPowerBICreateUpdate,
+		Read:   resourceArmStreamAnalyticsOutputPowerBIRead,
+		Update: resourceArmStreamAnalyticsOutputPowerBICreateUpdate,
+		Delete: resourceArmStreamAnalyticsOutputPowerBIDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"stream_analytics_job_name": streamAnalyticsJobNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"dataset": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"table": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateUUID,
+			},
+
+			"group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// the Refresh Token can only be obtained by authorizing the Output in the Azure Portal,
+			// as such a placeholder value can be specified here and then replaced out-of-band
+			"refresh_token": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+
+			// these are populated when the Output is authorized in the Azure Portal
+			"token_user_principal_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"token_user_display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmStreamAnalyticsOutputPowerBICreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsOutputsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Azure Stream Analytics Output PowerBI creation/update.")
+
+	name := d.Get("name").(string)
+	jobName := d.Get("stream_analytics_job_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	dataset := d.Get("dataset").(string)
+	table := d.Get("table").(string)
+	groupId := d.Get("group_id").(string)
+	groupName := d.Get("group_name").(string)
+	refreshToken := d.Get("refresh_token").(string)
+	tokenUserPrincipalName := d.Get("token_user_principal_name").(string)
+	tokenUserDisplayName := d.Get("token_user_display_name").(string)
+
+	powerBIProps := &streamanalytics.PowerBIOutputDataSourceProperties{
+		Dataset:                utils.String(dataset),
+		Table:                  utils.String(table),
+		GroupID:                utils.String(groupId),
+		GroupName:              utils.String(groupName),
+		RefreshToken:           utils.String(refreshToken),
+		TokenUserPrincipalName: utils.String(tokenUserPrincipalName),
+		TokenUserDisplayName:   utils.String(tokenUserDisplayName),
+	}
+
+	props := streamanalytics.Output{
+		Name: utils.String(name),
+		OutputProperties: &streamanalytics.OutputProperties{
+			Datasource: &streamanalytics.PowerBIOutputDataSource{
+				Type:                              streamanalytics.TypePowerBI,
+				PowerBIOutputDataSourceProperties: powerBIProps,
+			},
+		},
+	}
+
+	if d.IsNewResource() {
+		if _, err := client.CreateOrReplace(ctx, props, resourceGroup, jobName, name, "", ""); err != nil {
+			return fmt.Errorf("Error Creating Stream Analytics Output PowerBI %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+		}
+	} else {
+		// the configured Refresh Token is likely a placeholder, so only send it when it's changed to avoid
+		// overwriting the Refresh Token obtained by authorizing the Output in the Azure Portal
+		if !d.HasChange("refresh_token") {
+			powerBIProps.RefreshToken = nil
+		}
+
+		if _, err := client.Update(ctx, props, resourceGroup, jobName, name, ""); err != nil {
+			return fmt.Errorf("Error Updating Stream Analytics Output PowerBI %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+		}
+	}
+
+	read, err := client.Get(ctx, resourceGroup, jobName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Stream Analytics Output PowerBI %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Stream Analytics Output PowerBI %q (Job %q / Resource Group %q)", name, jobName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmStreamAnalyticsOutputPowerBIRead(d, meta)
+}
+
+func resourceArmStreamAnalyticsOutputPowerBIRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsOutputsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["outputs"]
+
+	resp, err := client.Get(ctx, resourceGroup, jobName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Stream Analytics Output PowerBI %q was not found in Job %q / Resource Group %q - removing from state!", name, jobName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Stream Analytics Output PowerBI %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("stream_analytics_job_name", jobName)
+
+	if props := resp.OutputProperties; props != nil {
+		v, ok := props.Datasource.AsPowerBIOutputDataSource()
+		if !ok {
+			return fmt.Errorf("Error converting Output Data Source to a PowerBI Output")
+		}
+
+		if powerBIProps := v.PowerBIOutputDataSourceProperties; powerBIProps != nil {
+			d.Set("dataset", powerBIProps.Dataset)
+			d.Set("table", powerBIProps.Table)
+			d.Set("group_id", powerBIProps.GroupID)
+			d.Set("group_name", powerBIProps.GroupName)
+			d.Set("token_user_principal_name", powerBIProps.TokenUserPrincipalName)
+			d.Set("token_user_display_name", powerBIProps.TokenUserDisplayName)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStreamAnalyticsOutputPowerBIDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsOutputsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["outputs"]
+
+	resp, err := client.Delete(ctx, resourceGroup, jobName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Stream Analytics Output PowerBI %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
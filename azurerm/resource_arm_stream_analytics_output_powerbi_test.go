@@ -0,0 +1,185 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMStreamAnalyticsOutputPowerBI_basic(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_powerbi.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMStreamAnalyticsOutputPowerBI_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputPowerBIDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputPowerBIExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dataset", "acctestdataset"),
+					resource.TestCheckResourceAttr(resourceName, "table", "acctesttable"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStreamAnalyticsOutputPowerBI_update(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_powerbi.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputPowerBIDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStreamAnalyticsOutputPowerBI_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputPowerBIExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMStreamAnalyticsOutputPowerBI_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputPowerBIExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "table", "updatedtable"),
+					resource.TestCheckResourceAttr(resourceName, "group_name", "acctestgroup"),
+					resource.TestCheckResourceAttr(resourceName, "token_user_principal_name", "user@example.com"),
+				),
+			},
+			{
+				// the token user fields are populated when authorizing the Output in the Azure Portal, so
+				// omitting them (and updating other fields) shouldn't clear them or the Refresh Token
+				Config: testAccAzureRMStreamAnalyticsOutputPowerBI_tokenUserOmitted(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputPowerBIExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dataset", "updateddataset"),
+					resource.TestCheckResourceAttr(resourceName, "token_user_principal_name", "user@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "token_user_display_name", "Example User"),
+				),
+			},
+			{
+				Config:   testAccAzureRMStreamAnalyticsOutputPowerBI_tokenUserOmitted(ri, location),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStreamAnalyticsOutputPowerBIExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		conn := testAccProvider.Meta().(*ArmClient).streamAnalyticsOutputsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := conn.Get(ctx, resourceGroup, jobName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Stream Analytics Output PowerBI %q (Job %q / Resource Group %q) does not exist", name, jobName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on streamAnalyticsOutputsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStreamAnalyticsOutputPowerBIDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).streamAnalyticsOutputsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_stream_analytics_output_powerbi" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		resp, err := conn.Get(ctx, resourceGroup, jobName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+
+			continue
+		}
+
+		return fmt.Errorf("Stream Analytics Output PowerBI %q (Job %q / Resource Group %q) still exists", name, jobName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMStreamAnalyticsOutputPowerBI_basic(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsJob_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_output_powerbi" "test" {
+  name                      = "acctestoutput-%d"
+  stream_analytics_job_name = "${lookup(azurerm_template_deployment.test.outputs, "jobName")}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  dataset                   = "acctestdataset"
+  table                     = "acctesttable"
+  refresh_token             = "placeholder"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMStreamAnalyticsOutputPowerBI_updated(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsJob_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_output_powerbi" "test" {
+  name                      = "acctestoutput-%d"
+  stream_analytics_job_name = "${lookup(azurerm_template_deployment.test.outputs, "jobName")}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  dataset                   = "acctestdataset"
+  table                     = "updatedtable"
+  group_id                  = "00000000-0000-0000-0000-000000000000"
+  group_name                = "acctestgroup"
+  refresh_token             = "placeholder"
+  token_user_principal_name = "user@example.com"
+  token_user_display_name   = "Example User"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMStreamAnalyticsOutputPowerBI_tokenUserOmitted(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsJob_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_output_powerbi" "test" {
+  name                      = "acctestoutput-%d"
+  stream_analytics_job_name = "${lookup(azurerm_template_deployment.test.outputs, "jobName")}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  dataset                   = "updateddataset"
+  table                     = "updatedtable"
+  group_id                  = "00000000-0000-0000-0000-000000000000"
+  group_name                = "acctestgroup"
+  refresh_token             = "placeholder"
+}
+`, template, rInt)
+}
@@ -0,0 +1,188 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/streamanalytics/mgmt/2016-03-01/streamanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStreamAnalyticsOutputServiceBusQueue() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStreamAnalyticsOutputServiceBusQueueCreateUpdate,
+		Read:   resourceArmStreamAnalyticsOutputServiceBusQueueRead,
+		Update: resourceArmStreamAnalyticsOutputServiceBusQueueCreateUpdate,
+		Delete: resourceArmStreamAnalyticsOutputServiceBusQueueDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"stream_analytics_job_name": streamAnalyticsJobNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"queue_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"servicebus_namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"shared_access_policy_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+
+			"shared_access_policy_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"property_columns": streamAnalyticsOutputPropertyColumnsSchema(),
+
+			"serialization": streamAnalyticsOutputSerializationSchema(),
+		},
+	}
+}
+
+func resourceArmStreamAnalyticsOutputServiceBusQueueCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsOutputsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Azure Stream Analytics Output ServiceBus Queue creation/update.")
+
+	name := d.Get("name").(string)
+	jobName := d.Get("stream_analytics_job_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	queueName := d.Get("queue_name").(string)
+	serviceBusNamespace := d.Get("servicebus_namespace").(string)
+	sharedAccessPolicyKey := d.Get("shared_access_policy_key").(string)
+	sharedAccessPolicyName := d.Get("shared_access_policy_name").(string)
+	propertyColumns := d.Get("property_columns").([]interface{})
+
+	serializationRaw := d.Get("serialization").([]interface{})
+	serialization, err := expandStreamAnalyticsOutputSerialization(serializationRaw)
+	if err != nil {
+		return fmt.Errorf("Error expanding `serialization`: %+v", err)
+	}
+
+	props := streamanalytics.Output{
+		Name: utils.String(name),
+		OutputProperties: &streamanalytics.OutputProperties{
+			Datasource: &streamanalytics.ServiceBusQueueOutputDataSource{
+				Type: streamanalytics.TypeMicrosoftServiceBusQueue,
+				ServiceBusQueueOutputDataSourceProperties: &streamanalytics.ServiceBusQueueOutputDataSourceProperties{
+					QueueName:              utils.String(queueName),
+					ServiceBusNamespace:    utils.String(serviceBusNamespace),
+					SharedAccessPolicyKey:  utils.String(sharedAccessPolicyKey),
+					SharedAccessPolicyName: utils.String(sharedAccessPolicyName),
+					PropertyColumns:        expandStreamAnalyticsOutputPropertyColumns(propertyColumns),
+				},
+			},
+			Serialization: serialization,
+		},
+	}
+
+	if _, err := client.CreateOrReplace(ctx, props, resourceGroup, jobName, name, "", ""); err != nil {
+		return fmt.Errorf("Error Creating/Updating Stream Analytics Output ServiceBus Queue %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, jobName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Stream Analytics Output ServiceBus Queue %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Stream Analytics Output ServiceBus Queue %q (Job %q / Resource Group %q)", name, jobName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmStreamAnalyticsOutputServiceBusQueueRead(d, meta)
+}
+
+func resourceArmStreamAnalyticsOutputServiceBusQueueRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsOutputsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["outputs"]
+
+	resp, err := client.Get(ctx, resourceGroup, jobName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Stream Analytics Output ServiceBus Queue %q was not found in Job %q / Resource Group %q - removing from state!", name, jobName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Stream Analytics Output ServiceBus Queue %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("stream_analytics_job_name", jobName)
+
+	if props := resp.OutputProperties; props != nil {
+		v, ok := props.Datasource.AsServiceBusQueueOutputDataSource()
+		if !ok {
+			return fmt.Errorf("Error converting Output Data Source to a ServiceBus Queue Output")
+		}
+
+		if queueProps := v.ServiceBusQueueOutputDataSourceProperties; queueProps != nil {
+			d.Set("queue_name", queueProps.QueueName)
+			d.Set("servicebus_namespace", queueProps.ServiceBusNamespace)
+			d.Set("shared_access_policy_name", queueProps.SharedAccessPolicyName)
+
+			if err := d.Set("property_columns", flattenStreamAnalyticsOutputPropertyColumns(queueProps.PropertyColumns)); err != nil {
+				return fmt.Errorf("Error setting `property_columns`: %+v", err)
+			}
+		}
+
+		if err := d.Set("serialization", flattenStreamAnalyticsOutputSerialization(props.Serialization)); err != nil {
+			return fmt.Errorf("Error setting `serialization`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStreamAnalyticsOutputServiceBusQueueDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsOutputsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["outputs"]
+
+	resp, err := client.Delete(ctx, resourceGroup, jobName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Stream Analytics Output ServiceBus Queue %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,271 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMStreamAnalyticsOutputServiceBusTopic_avro(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_servicebus_topic.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMStreamAnalyticsOutputServiceBusTopic_avro(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputServiceBusTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputServiceBusTopicExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "serialization.0.type", "Avro"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStreamAnalyticsOutputServiceBusTopic_csv(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_servicebus_topic.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMStreamAnalyticsOutputServiceBusTopic_csv(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputServiceBusTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputServiceBusTopicExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "serialization.0.type", "Csv"),
+					resource.TestCheckResourceAttr(resourceName, "serialization.0.field_delimiter", ","),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStreamAnalyticsOutputServiceBusTopic_json(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_servicebus_topic.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMStreamAnalyticsOutputServiceBusTopic_json(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputServiceBusTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputServiceBusTopicExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "serialization.0.type", "Json"),
+					resource.TestCheckResourceAttr(resourceName, "serialization.0.format", "LineSeparated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStreamAnalyticsOutputServiceBusTopic_update(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_servicebus_topic.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputServiceBusTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStreamAnalyticsOutputServiceBusTopic_json(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputServiceBusTopicExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMStreamAnalyticsOutputServiceBusTopic_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputServiceBusTopicExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "property_columns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "property_columns.0", "col1"),
+					resource.TestCheckResourceAttr(resourceName, "property_columns.1", "col2"),
+					resource.TestCheckResourceAttr(resourceName, "serialization.0.type", "Json"),
+					resource.TestCheckResourceAttr(resourceName, "serialization.0.format", "Array"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStreamAnalyticsOutputServiceBusTopicExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		conn := testAccProvider.Meta().(*ArmClient).streamAnalyticsOutputsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := conn.Get(ctx, resourceGroup, jobName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Stream Analytics Output ServiceBus Topic %q (Job %q / Resource Group %q) does not exist", name, jobName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on streamAnalyticsOutputsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStreamAnalyticsOutputServiceBusTopicDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).streamAnalyticsOutputsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_stream_analytics_output_servicebus_topic" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		resp, err := conn.Get(ctx, resourceGroup, jobName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+
+			continue
+		}
+
+		return fmt.Errorf("Stream Analytics Output ServiceBus Topic %q (Job %q / Resource Group %q) still exists", name, jobName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMStreamAnalyticsOutputServiceBusTopic_avro(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsOutputServiceBusTopic_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_output_servicebus_topic" "test" {
+  name                      = "acctestoutput-%d"
+  stream_analytics_job_name = "${lookup(azurerm_template_deployment.test.outputs, "jobName")}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  topic_name                = "${azurerm_servicebus_topic.test.name}"
+  servicebus_namespace      = "${azurerm_servicebus_namespace.test.name}"
+  shared_access_policy_key  = "${azurerm_servicebus_namespace.test.default_primary_key}"
+  shared_access_policy_name = "RootManageSharedAccessKey"
+
+  serialization {
+    type = "Avro"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMStreamAnalyticsOutputServiceBusTopic_csv(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsOutputServiceBusTopic_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_output_servicebus_topic" "test" {
+  name                      = "acctestoutput-%d"
+  stream_analytics_job_name = "${lookup(azurerm_template_deployment.test.outputs, "jobName")}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  topic_name                = "${azurerm_servicebus_topic.test.name}"
+  servicebus_namespace      = "${azurerm_servicebus_namespace.test.name}"
+  shared_access_policy_key  = "${azurerm_servicebus_namespace.test.default_primary_key}"
+  shared_access_policy_name = "RootManageSharedAccessKey"
+
+  serialization {
+    type            = "Csv"
+    encoding        = "UTF8"
+    field_delimiter = ","
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMStreamAnalyticsOutputServiceBusTopic_json(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsOutputServiceBusTopic_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_output_servicebus_topic" "test" {
+  name                      = "acctestoutput-%d"
+  stream_analytics_job_name = "${lookup(azurerm_template_deployment.test.outputs, "jobName")}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  topic_name                = "${azurerm_servicebus_topic.test.name}"
+  servicebus_namespace      = "${azurerm_servicebus_namespace.test.name}"
+  shared_access_policy_key  = "${azurerm_servicebus_namespace.test.default_primary_key}"
+  shared_access_policy_name = "RootManageSharedAccessKey"
+
+  serialization {
+    type     = "Json"
+    encoding = "UTF8"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMStreamAnalyticsOutputServiceBusTopic_updated(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsOutputServiceBusTopic_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_output_servicebus_topic" "test" {
+  name                      = "acctestoutput-%d"
+  stream_analytics_job_name = "${lookup(azurerm_template_deployment.test.outputs, "jobName")}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  topic_name                = "${azurerm_servicebus_topic.test.name}"
+  servicebus_namespace      = "${azurerm_servicebus_namespace.test.name}"
+  shared_access_policy_key  = "${azurerm_servicebus_namespace.test.default_primary_key}"
+  shared_access_policy_name = "RootManageSharedAccessKey"
+  property_columns          = ["col1", "col2"]
+
+  serialization {
+    type     = "Json"
+    encoding = "UTF8"
+    format   = "Array"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMStreamAnalyticsOutputServiceBusTopic_template(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsJob_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "standard"
+}
+
+resource "azurerm_servicebus_topic" "test" {
+  name                = "acctest-%d"
+  namespace_name      = "${azurerm_servicebus_namespace.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, template, rInt, rInt)
+}
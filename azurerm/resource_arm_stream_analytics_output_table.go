@@ -0,0 +1,212 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/streamanalytics/mgmt/2016-03-01/streamanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStreamAnalyticsOutputTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStreamAnalyticsOutputTableCreateUpdate,
+		Read:   resourceArmStreamAnalyticsOutputTableRead,
+		Update: resourceArmStreamAnalyticsOutputTableCreateUpdate,
+		Delete: resourceArmStreamAnalyticsOutputTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"stream_analytics_job_name": streamAnalyticsJobNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"storage_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"storage_account_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+
+			"table": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"partition_key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"row_key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"batch_size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"columns_to_remove": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmStreamAnalyticsOutputTableCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsOutputsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for Azure Stream Analytics Output Table creation/update.")
+
+	name := d.Get("name").(string)
+	jobName := d.Get("stream_analytics_job_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	accountName := d.Get("storage_account_name").(string)
+	accountKey := d.Get("storage_account_key").(string)
+	table := d.Get("table").(string)
+	partitionKey := d.Get("partition_key").(string)
+	rowKey := d.Get("row_key").(string)
+	batchSize := int32(d.Get("batch_size").(int))
+
+	columnsToRemove := make([]string, 0)
+	for _, v := range d.Get("columns_to_remove").([]interface{}) {
+		columnsToRemove = append(columnsToRemove, v.(string))
+	}
+
+	props := streamanalytics.Output{
+		Name: utils.String(name),
+		OutputProperties: &streamanalytics.OutputProperties{
+			Datasource: &streamanalytics.AzureTableOutputDataSource{
+				Type: streamanalytics.TypeMicrosoftStorageTable,
+				AzureTableOutputDataSourceProperties: &streamanalytics.AzureTableOutputDataSourceProperties{
+					AccountName:     utils.String(accountName),
+					AccountKey:      utils.String(accountKey),
+					Table:           utils.String(table),
+					PartitionKey:    utils.String(partitionKey),
+					RowKey:          utils.String(rowKey),
+					BatchSize:       utils.Int32(batchSize),
+					ColumnsToRemove: &columnsToRemove,
+				},
+			},
+		},
+	}
+
+	if _, err := client.CreateOrReplace(ctx, props, resourceGroup, jobName, name, "", ""); err != nil {
+		return fmt.Errorf("Error Creating/Updating Stream Analytics Output Table %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, jobName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Stream Analytics Output Table %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Stream Analytics Output Table %q (Job %q / Resource Group %q)", name, jobName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmStreamAnalyticsOutputTableRead(d, meta)
+}
+
+func resourceArmStreamAnalyticsOutputTableRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsOutputsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["outputs"]
+
+	resp, err := client.Get(ctx, resourceGroup, jobName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Stream Analytics Output Table %q was not found in Job %q / Resource Group %q - removing from state!", name, jobName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Stream Analytics Output Table %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("stream_analytics_job_name", jobName)
+
+	if props := resp.OutputProperties; props != nil {
+		v, ok := props.Datasource.AsAzureTableOutputDataSource()
+		if !ok {
+			return fmt.Errorf("Error converting Output Data Source to a Table Output")
+		}
+
+		if tableProps := v.AzureTableOutputDataSourceProperties; tableProps != nil {
+			d.Set("storage_account_name", tableProps.AccountName)
+			d.Set("table", tableProps.Table)
+			d.Set("partition_key", tableProps.PartitionKey)
+			d.Set("row_key", tableProps.RowKey)
+
+			if tableProps.BatchSize != nil {
+				d.Set("batch_size", int(*tableProps.BatchSize))
+			}
+
+			columnsToRemove := make([]interface{}, 0)
+			if tableProps.ColumnsToRemove != nil {
+				for _, v := range *tableProps.ColumnsToRemove {
+					columnsToRemove = append(columnsToRemove, v)
+				}
+			}
+			if err := d.Set("columns_to_remove", columnsToRemove); err != nil {
+				return fmt.Errorf("Error setting `columns_to_remove`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStreamAnalyticsOutputTableDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsOutputsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["outputs"]
+
+	resp, err := client.Delete(ctx, resourceGroup, jobName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Stream Analytics Output Table %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
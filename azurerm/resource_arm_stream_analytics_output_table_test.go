@@ -0,0 +1,180 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMStreamAnalyticsOutputTable_basic(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_table.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(5))
+	config := testAccAzureRMStreamAnalyticsOutputTable_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "batch_size", "100"),
+					resource.TestCheckResourceAttr(resourceName, "columns_to_remove.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStreamAnalyticsOutputTable_update(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_table.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(5))
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStreamAnalyticsOutputTable_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputTableExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMStreamAnalyticsOutputTable_updated(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "batch_size", "50"),
+					resource.TestCheckResourceAttr(resourceName, "columns_to_remove.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "columns_to_remove.0", "partitionId"),
+					resource.TestCheckResourceAttr(resourceName, "columns_to_remove.1", "rowId"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStreamAnalyticsOutputTableExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		conn := testAccProvider.Meta().(*ArmClient).streamAnalyticsOutputsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := conn.Get(ctx, resourceGroup, jobName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Stream Analytics Output Table %q (Job %q / Resource Group %q) does not exist", name, jobName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on streamAnalyticsOutputsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStreamAnalyticsOutputTableDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).streamAnalyticsOutputsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_stream_analytics_output_table" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		resp, err := conn.Get(ctx, resourceGroup, jobName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+
+			continue
+		}
+
+		return fmt.Errorf("Stream Analytics Output Table %q (Job %q / Resource Group %q) still exists", name, jobName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMStreamAnalyticsOutputTable_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMStreamAnalyticsOutputTable_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_output_table" "test" {
+  name                      = "acctestoutput-%d"
+  stream_analytics_job_name = "${lookup(azurerm_template_deployment.test.outputs, "jobName")}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  storage_account_name      = "${azurerm_storage_account.test.name}"
+  storage_account_key       = "${azurerm_storage_account.test.primary_access_key}"
+  table                     = "${azurerm_storage_table.test.name}"
+  partition_key             = "partitionId"
+  row_key                   = "rowId"
+  batch_size                = 100
+}
+`, template, rInt)
+}
+
+func testAccAzureRMStreamAnalyticsOutputTable_updated(rInt int, rString string, location string) string {
+	template := testAccAzureRMStreamAnalyticsOutputTable_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_output_table" "test" {
+  name                      = "acctestoutput-%d"
+  stream_analytics_job_name = "${lookup(azurerm_template_deployment.test.outputs, "jobName")}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  storage_account_name      = "${azurerm_storage_account.test.name}"
+  storage_account_key       = "${azurerm_storage_account.test.primary_access_key}"
+  table                     = "${azurerm_storage_table.test.name}"
+  partition_key             = "partitionId"
+  row_key                   = "rowId"
+  batch_size                = 50
+  columns_to_remove         = ["partitionId", "rowId"]
+}
+`, template, rInt)
+}
+
+func testAccAzureRMStreamAnalyticsOutputTable_template(rInt int, rString string, location string) string {
+	template := testAccAzureRMStreamAnalyticsJob_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_table" "test" {
+  name                 = "acctesttable%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+`, template, rString, rInt)
+}
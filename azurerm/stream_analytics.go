@@ -0,0 +1,239 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/streamanalytics/mgmt/2016-03-01/streamanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func streamAnalyticsJobNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+}
+
+func streamAnalyticsOutputSerializationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(streamanalytics.TypeAvro),
+						string(streamanalytics.TypeCsv),
+						string(streamanalytics.TypeJSON),
+					}, false),
+				},
+
+				"field_delimiter": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						" ",
+						",",
+						"\t",
+						"|",
+						";",
+					}, false),
+				},
+
+				"encoding": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(streamanalytics.UTF8),
+					}, false),
+				},
+
+				"format": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(streamanalytics.Array),
+						string(streamanalytics.LineSeparated),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func streamAnalyticsOutputPropertyColumnsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validateNoEmptyStrings,
+		},
+	}
+}
+
+func expandStreamAnalyticsOutputSerialization(input []interface{}) (streamanalytics.BasicSerialization, error) {
+	v := input[0].(map[string]interface{})
+
+	outputType := streamanalytics.Type(v["type"].(string))
+	encoding := v["encoding"].(string)
+	fieldDelimiter := v["field_delimiter"].(string)
+	format := v["format"].(string)
+
+	if err := validateSerializationEncoding(outputType, encoding); err != nil {
+		return nil, err
+	}
+
+	switch outputType {
+	case streamanalytics.TypeAvro:
+		if fieldDelimiter != "" {
+			return nil, fmt.Errorf("`field_delimiter` cannot be set when `type` is set to `Avro`")
+		}
+
+		return streamanalytics.AvroSerialization{
+			Type:       streamanalytics.TypeAvro,
+			Properties: &map[string]interface{}{},
+		}, nil
+
+	case streamanalytics.TypeCsv:
+		if fieldDelimiter == "" {
+			return nil, fmt.Errorf("`field_delimiter` must be specified when `type` is set to `Csv`")
+		}
+
+		return streamanalytics.CsvSerialization{
+			Type: streamanalytics.TypeCsv,
+			CsvSerializationProperties: &streamanalytics.CsvSerializationProperties{
+				Encoding:       streamanalytics.Encoding(encoding),
+				FieldDelimiter: utils.String(fieldDelimiter),
+			},
+		}, nil
+
+	case streamanalytics.TypeJSON:
+		if fieldDelimiter != "" {
+			return nil, fmt.Errorf("`field_delimiter` cannot be set when `type` is set to `Json`")
+		}
+
+		props := &streamanalytics.JSONSerializationProperties{
+			Encoding: streamanalytics.Encoding(encoding),
+		}
+		if format != "" {
+			props.Format = streamanalytics.JSONOutputSerializationFormat(format)
+		}
+
+		return streamanalytics.JSONSerialization{
+			Type:                        streamanalytics.TypeJSON,
+			JSONSerializationProperties: props,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("Unsupported Output Type %q", outputType)
+}
+
+// streamAnalyticsSerializationEncodings lists the encodings each Serialization Type accepts -
+// a Type without any encodings (e.g. Avro) doesn't allow one to be specified at all.
+var streamAnalyticsSerializationEncodings = map[streamanalytics.Type][]streamanalytics.Encoding{
+	streamanalytics.TypeAvro: {},
+	streamanalytics.TypeCsv:  {streamanalytics.UTF8},
+	streamanalytics.TypeJSON: {streamanalytics.UTF8},
+}
+
+func validateSerializationEncoding(serializationType streamanalytics.Type, encoding string) error {
+	encodings, ok := streamAnalyticsSerializationEncodings[serializationType]
+	if !ok {
+		return fmt.Errorf("Unsupported Serialization Type %q", serializationType)
+	}
+
+	if len(encodings) == 0 {
+		if encoding != "" {
+			return fmt.Errorf("`encoding` cannot be set when `type` is set to `%s`", serializationType)
+		}
+
+		return nil
+	}
+
+	if encoding == "" {
+		return fmt.Errorf("`encoding` must be specified when `type` is set to `%s`", serializationType)
+	}
+
+	for _, v := range encodings {
+		if string(v) == encoding {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("`encoding` %q is not supported when `type` is set to `%s` - supported values are %+v", encoding, serializationType, encodings)
+}
+
+func flattenStreamAnalyticsOutputSerialization(input streamanalytics.BasicSerialization) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	var encoding string
+	var outputType string
+	var fieldDelimiter string
+	var format string
+
+	if _, ok := input.AsAvroSerialization(); ok {
+		outputType = string(streamanalytics.TypeAvro)
+	}
+
+	if v, ok := input.AsCsvSerialization(); ok {
+		if props := v.CsvSerializationProperties; props != nil {
+			if props.FieldDelimiter != nil {
+				fieldDelimiter = *props.FieldDelimiter
+			}
+
+			encoding = string(props.Encoding)
+		}
+
+		outputType = string(streamanalytics.TypeCsv)
+	}
+
+	if v, ok := input.AsJSONSerialization(); ok {
+		if props := v.JSONSerializationProperties; props != nil {
+			encoding = string(props.Encoding)
+			format = string(props.Format)
+		}
+
+		outputType = string(streamanalytics.TypeJSON)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"encoding":        encoding,
+			"type":            outputType,
+			"format":          format,
+			"field_delimiter": fieldDelimiter,
+		},
+	}
+}
+
+func expandStreamAnalyticsOutputPropertyColumns(input []interface{}) *[]string {
+	columns := make([]string, 0)
+	for _, v := range input {
+		columns = append(columns, v.(string))
+	}
+
+	return &columns
+}
+
+func flattenStreamAnalyticsOutputPropertyColumns(input *[]string) []interface{} {
+	columns := make([]interface{}, 0)
+	if input == nil {
+		return columns
+	}
+
+	for _, v := range *input {
+		columns = append(columns, v)
+	}
+
+	return columns
+}
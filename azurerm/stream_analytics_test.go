@@ -0,0 +1,245 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/streamanalytics/mgmt/2016-03-01/streamanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestExpandStreamAnalyticsOutputSerialization(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Input         map[string]interface{}
+		ExpectedType  streamanalytics.Type
+		ExpectedError bool
+	}{
+		{
+			Name: "Avro",
+			Input: map[string]interface{}{
+				"type":            "Avro",
+				"encoding":        "",
+				"field_delimiter": "",
+				"format":          "",
+			},
+			ExpectedType: streamanalytics.TypeAvro,
+		},
+		{
+			Name: "Avro with an Encoding",
+			Input: map[string]interface{}{
+				"type":            "Avro",
+				"encoding":        "UTF8",
+				"field_delimiter": "",
+				"format":          "",
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "Csv",
+			Input: map[string]interface{}{
+				"type":            "Csv",
+				"encoding":        "UTF8",
+				"field_delimiter": ",",
+				"format":          "",
+			},
+			ExpectedType: streamanalytics.TypeCsv,
+		},
+		{
+			Name: "Csv without a Field Delimiter",
+			Input: map[string]interface{}{
+				"type":            "Csv",
+				"encoding":        "UTF8",
+				"field_delimiter": "",
+				"format":          "",
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "Json",
+			Input: map[string]interface{}{
+				"type":            "Json",
+				"encoding":        "UTF8",
+				"field_delimiter": "",
+				"format":          "Array",
+			},
+			ExpectedType: streamanalytics.TypeJSON,
+		},
+		{
+			Name: "Json without an Encoding",
+			Input: map[string]interface{}{
+				"type":            "Json",
+				"encoding":        "",
+				"field_delimiter": "",
+				"format":          "",
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		serialization, err := expandStreamAnalyticsOutputSerialization([]interface{}{tc.Input})
+		if err != nil {
+			if tc.ExpectedError {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %q but got: %+v", tc.Name, err)
+		}
+
+		if tc.ExpectedError {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.Name)
+		}
+
+		flattened := flattenStreamAnalyticsOutputSerialization(serialization)
+		actual := flattened[0].(map[string]interface{})
+		if actual["type"] != string(tc.ExpectedType) {
+			t.Fatalf("Expected %q to round-trip as %q but got %q", tc.Name, tc.ExpectedType, actual["type"])
+		}
+
+		for _, key := range []string{"encoding", "field_delimiter", "format"} {
+			if actual[key] != tc.Input[key] {
+				t.Fatalf("Expected %q to round-trip %q as %q but got %q", tc.Name, key, tc.Input[key], actual[key])
+			}
+		}
+	}
+}
+
+func TestValidateSerializationEncoding(t *testing.T) {
+	cases := []struct {
+		Type     streamanalytics.Type
+		Encoding string
+		Errors   bool
+	}{
+		{
+			Type:     streamanalytics.TypeAvro,
+			Encoding: "",
+			Errors:   false,
+		},
+		{
+			Type:     streamanalytics.TypeAvro,
+			Encoding: "UTF8",
+			Errors:   true,
+		},
+		{
+			Type:     streamanalytics.TypeCsv,
+			Encoding: "UTF8",
+			Errors:   false,
+		},
+		{
+			Type:     streamanalytics.TypeCsv,
+			Encoding: "",
+			Errors:   true,
+		},
+		{
+			Type:     streamanalytics.TypeJSON,
+			Encoding: "UTF8",
+			Errors:   false,
+		},
+		{
+			Type:     streamanalytics.TypeJSON,
+			Encoding: "UTF16",
+			Errors:   true,
+		},
+		{
+			Type:     streamanalytics.Type("Parquet"),
+			Encoding: "",
+			Errors:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateSerializationEncoding(tc.Type, tc.Encoding)
+		if tc.Errors && err == nil {
+			t.Fatalf("Expected an error for Type %q / Encoding %q but didn't get one", tc.Type, tc.Encoding)
+		}
+		if !tc.Errors && err != nil {
+			t.Fatalf("Expected no error for Type %q / Encoding %q but got: %+v", tc.Type, tc.Encoding, err)
+		}
+	}
+}
+
+// TestStreamAnalyticsSecretsAreSensitive ensures every credential exposed by the Stream Analytics resources
+// is marked as Sensitive, so that it's never output in plaintext in the plan
+func TestStreamAnalyticsSecretsAreSensitive(t *testing.T) {
+	secretSuffixes := []string{"account_key", "policy_key", "password", "secret", "token"}
+
+	var checkSchema func(resourceName string, path string, s map[string]*schema.Schema)
+	checkSchema = func(resourceName string, path string, s map[string]*schema.Schema) {
+		for name, v := range s {
+			fieldPath := path + name
+
+			for _, suffix := range secretSuffixes {
+				if strings.HasSuffix(name, suffix) && !v.Sensitive {
+					t.Fatalf("Expected %q in %q to be marked as Sensitive", fieldPath, resourceName)
+				}
+			}
+
+			if nested, ok := v.Elem.(*schema.Resource); ok {
+				checkSchema(resourceName, fieldPath+".", nested.Schema)
+			}
+		}
+	}
+
+	provider := Provider().(*schema.Provider)
+	for name, resource := range provider.ResourcesMap {
+		if strings.HasPrefix(name, "azurerm_stream_analytics_") {
+			checkSchema(name, "", resource.Schema)
+		}
+	}
+	for name, resource := range provider.DataSourcesMap {
+		if strings.HasPrefix(name, "azurerm_stream_analytics_") {
+			checkSchema(name, "", resource.Schema)
+		}
+	}
+}
+
+// testAccAzureRMStreamAnalyticsJob_template provisions a Stream Analytics Job via a Template Deployment,
+// since there's no Job resource available to build the Inputs/Outputs on top of.
+func testAccAzureRMStreamAnalyticsJob_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "Incremental"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.StreamAnalytics/streamingjobs",
+      "apiVersion": "2016-03-01",
+      "name": "acctestjob-%d",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "sku": {
+          "name": "Standard"
+        },
+        "eventsOutOfOrderPolicy": "Adjust",
+        "outputErrorPolicy": "Drop",
+        "eventsOutOfOrderMaxDelayInSeconds": 0,
+        "eventsLateArrivalMaxDelayInSeconds": 5,
+        "dataLocale": "en-GB",
+        "compatibilityLevel": "1.0"
+      }
+    }
+  ],
+  "outputs": {
+    "jobName": {
+      "type": "string",
+      "value": "acctestjob-%d"
+    }
+  }
+}
+DEPLOY
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/Azure/go-autorest/autorest/date"
@@ -101,3 +102,17 @@ func validateIso8601Duration() schema.SchemaValidateFunc {
 		return
 	}
 }
+
+func validateNoEmptyStrings(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if strings.TrimSpace(v) == "" {
+		es = append(es, fmt.Errorf("%q must not be empty", k))
+	}
+
+	return
+}
@@ -171,3 +171,31 @@ func TestValidateIso8601Duration(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateNoEmptyStrings(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  "   ",
+			Errors: 1,
+		},
+		{
+			Value:  "partitionId",
+			Errors: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateNoEmptyStrings(tc.Value, "example")
+
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected validateNoEmptyStrings to trigger '%d' errors for '%s' - got '%d'", tc.Errors, tc.Value, len(errors))
+		}
+	}
+}